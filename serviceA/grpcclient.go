@@ -0,0 +1,51 @@
+package main
+
+import (
+	"github.com/walterlicinio/climacep-otel/weatherpb"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+var weatherClient weatherpb.WeatherClient
+
+// dialServiceB opens the gRPC connection to Service B with otelgrpc's
+// client handler, so a gRPC call produces the same Zipkin trace tree as the
+// HTTP path.
+func dialServiceB(addr string) (weatherpb.WeatherClient, error) {
+	conn, err := grpc.NewClient(addr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return weatherpb.NewWeatherClient(conn), nil
+}
+
+func pbLocationType(locationType string) weatherpb.LocationType {
+	switch locationType {
+	case "cep":
+		return weatherpb.LocationType_LOCATION_TYPE_CEP
+	case "city":
+		return weatherpb.LocationType_LOCATION_TYPE_CITY
+	case "coords":
+		return weatherpb.LocationType_LOCATION_TYPE_COORDS
+	default:
+		return weatherpb.LocationType_LOCATION_TYPE_UNSPECIFIED
+	}
+}
+
+func pbUnits(units string) weatherpb.Units {
+	switch units {
+	case "metric":
+		return weatherpb.Units_UNITS_METRIC
+	case "imperial":
+		return weatherpb.Units_UNITS_IMPERIAL
+	case "standard":
+		return weatherpb.Units_UNITS_STANDARD
+	default:
+		return weatherpb.Units_UNITS_UNSPECIFIED
+	}
+}
@@ -1,11 +1,13 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"log"
 	"net/http"
+	"os"
 	"strings"
 
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
@@ -16,10 +18,22 @@ import (
 	"go.opentelemetry.io/otel/sdk/resource"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/walterlicinio/climacep-otel/weatherpb"
 )
 
 var tracer trace.Tracer
 
+// serviceBTransport picks HTTP or gRPC for the Service A -> Service B hop.
+func serviceBTransport() string {
+	if os.Getenv("SERVICEB_TRANSPORT") == "grpc" {
+		return "grpc"
+	}
+	return "http"
+}
+
 func initTracer() {
 	exporter, err := stdouttrace.New(stdouttrace.WithPrettyPrint())
 	if err != nil {
@@ -57,49 +71,200 @@ func validateCep(cep string) bool {
 	return true
 }
 
-func handler(w http.ResponseWriter, r *http.Request) {
-	ctx, span := tracer.Start(r.Context(), "handler")
-	defer span.End()
+func validateLocation(locationType, value string) bool {
+	switch locationType {
+	case "cep":
+		return validateCep(value)
+	case "city":
+		return value != ""
+	case "coords":
+		return value != ""
+	default:
+		return false
+	}
+}
+
+// newForwardHandler builds a handler that validates the request and relays
+// it to Service B over HTTP or gRPC depending on SERVICEB_TRANSPORT,
+// starting a span named after the operation so Zipkin can tell the
+// current-weather and forecast paths apart regardless of transport.
+func newForwardHandler(spanName, upstreamPath, rpcKind string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, span := tracer.Start(r.Context(), spanName)
+		defer span.End()
+
+		var request struct {
+			LocationType string `json:"location_type"`
+			Value        string `json:"value"`
+			Units        string `json:"units"`
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, "could not read request body", http.StatusInternalServerError)
+			return
+		}
+
+		if err := json.Unmarshal(body, &request); err != nil {
+			http.Error(w, "invalid request format", http.StatusBadRequest)
+			return
+		}
+
+		span.SetAttributes(
+			attribute.String("location.type", request.LocationType),
+			attribute.String("units", request.Units),
+		)
+
+		if !validateLocation(request.LocationType, request.Value) {
+			http.Error(w, "invalid location", http.StatusUnprocessableEntity)
+			return
+		}
+
+		if serviceBTransport() == "grpc" {
+			forwardGRPC(ctx, w, rpcKind, request.LocationType, request.Value, request.Units)
+			return
+		}
+
+		req, _ := http.NewRequestWithContext(ctx, "POST", "http://serviceb:8081"+upstreamPath, strings.NewReader(string(body)))
+		client := http.Client{Transport: otelhttp.NewTransport(http.DefaultTransport)}
+
+		resp, err := client.Do(req)
+		if err != nil {
+			http.Error(w, "could not communicate with service b", http.StatusInternalServerError)
+			return
+		}
+		defer resp.Body.Close()
 
-	var request struct {
-		Cep string `json:"cep"`
+		responseBody, _ := io.ReadAll(resp.Body)
+		w.WriteHeader(resp.StatusCode)
+		w.Write(responseBody)
 	}
+}
 
-	body, err := io.ReadAll(r.Body)
-	if err != nil {
-		http.Error(w, "could not read request body", http.StatusInternalServerError)
-		return
+// forwardGRPC relays a request to Service B's gRPC surface and re-encodes
+// the typed reply as the same JSON shape the HTTP path returns.
+func forwardGRPC(ctx context.Context, w http.ResponseWriter, rpcKind, locationType, value, units string) {
+	pbReq := &weatherpb.RequestCurrent{
+		LocationType: pbLocationType(locationType),
+		Value:        value,
+		Units:        pbUnits(units),
 	}
 
-	if err := json.Unmarshal(body, &request); err != nil {
-		http.Error(w, "invalid request format", http.StatusBadRequest)
-		return
+	var response interface{}
+	var err error
+
+	switch rpcKind {
+	case "forecast":
+		var reply *weatherpb.SendForecast
+		reply, err = weatherClient.FiveDay(ctx, pbReq)
+		if err == nil {
+			days := make([]map[string]interface{}, 0, len(reply.GetDays()))
+			for _, d := range reply.GetDays() {
+				day := map[string]interface{}{
+					"date":          d.GetDate(),
+					"tempMin":       d.GetTempMin(),
+					"tempMax":       d.GetTempMax(),
+					"precipitation": d.GetPrecipitation(),
+				}
+				if d.GetCondition() != "" {
+					day["condition"] = d.GetCondition()
+				}
+				if d.GetHumidity() != 0 {
+					day["humidity"] = d.GetHumidity()
+				}
+				if d.GetPressureHpa() != 0 {
+					day["pressure_hpa"] = d.GetPressureHpa()
+				}
+				if d.GetWindSpeed() != 0 {
+					day["wind_speed"] = d.GetWindSpeed()
+				}
+				if d.GetWindDeg() != 0 {
+					day["wind_deg"] = d.GetWindDeg()
+				}
+				if d.GetCloudCoverPct() != 0 {
+					day["cloud_cover_pct"] = d.GetCloudCoverPct()
+				}
+				days = append(days, day)
+			}
+			forecast := map[string]interface{}{"unit": reply.GetUnit(), "forecast": days}
+			if reply.GetCity() != "" {
+				forecast["city"] = reply.GetCity()
+			}
+			response = forecast
+		}
+	default:
+		var reply *weatherpb.SendCurrent
+		reply, err = weatherClient.Forecast(ctx, pbReq)
+		if err == nil {
+			current := map[string]interface{}{
+				"humidity":        reply.GetHumidity(),
+				"pressure_hpa":    reply.GetPressureHpa(),
+				"wind_speed":      reply.GetWindSpeed(),
+				"wind_deg":        reply.GetWindDeg(),
+				"cloud_cover_pct": reply.GetCloudCoverPct(),
+				"condition":       reply.GetCondition(),
+			}
+			if units != "" {
+				current["temp"] = reply.GetTemp()
+				current["unit"] = reply.GetUnit()
+			} else {
+				tempC := reply.GetTemp()
+				current["temp_C"] = tempC
+				current["temp_F"] = tempC*1.8 + 32
+				current["temp_K"] = tempC + 273
+			}
+			if reply.GetCity() != "" {
+				current["city"] = reply.GetCity()
+			}
+			response = current
+		}
 	}
 
-	if !validateCep(request.Cep) {
-		http.Error(w, "invalid zipcode", http.StatusUnprocessableEntity)
+	if err != nil {
+		httpStatus, msg := grpcErrorStatus(err)
+		http.Error(w, msg, httpStatus)
 		return
 	}
 
-	req, _ := http.NewRequestWithContext(ctx, "POST", "http://serviceb:8081/cep", strings.NewReader(string(body)))
-	client := http.Client{Transport: otelhttp.NewTransport(http.DefaultTransport)}
+	respBody, _ := json.Marshal(response)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(respBody)
+}
 
-	resp, err := client.Do(req)
-	if err != nil {
-		http.Error(w, "could not communicate with service b", http.StatusInternalServerError)
-		return
+// grpcErrorStatus maps a gRPC error back to the HTTP status the HTTP
+// transport path would have returned for the same failure, so the
+// response a client sees doesn't depend on SERVICEB_TRANSPORT.
+func grpcErrorStatus(err error) (int, string) {
+	st, ok := status.FromError(err)
+	if !ok {
+		return http.StatusInternalServerError, "could not communicate with service b"
 	}
-	defer resp.Body.Close()
 
-	responseBody, _ := io.ReadAll(resp.Body)
-	w.WriteHeader(resp.StatusCode)
-	w.Write(responseBody)
+	switch st.Code() {
+	case codes.InvalidArgument:
+		return http.StatusUnprocessableEntity, st.Message()
+	case codes.NotFound:
+		return http.StatusNotFound, st.Message()
+	case codes.Internal:
+		return http.StatusInternalServerError, st.Message()
+	default:
+		return http.StatusInternalServerError, "could not communicate with service b"
+	}
 }
 
 func main() {
 	initTracer()
+
+	client, err := dialServiceB("serviceb:9091")
+	if err != nil {
+		log.Fatalf("failed to dial service b gRPC: %v", err)
+	}
+	weatherClient = client
+
 	mux := http.NewServeMux()
-	mux.Handle("/", otelhttp.NewHandler(http.HandlerFunc(handler), "handler"))
+	mux.Handle("/", otelhttp.NewHandler(newForwardHandler("currentForecast", "/cep", "current"), "currentForecast"))
+	mux.Handle("/forecast", otelhttp.NewHandler(newForwardHandler("fiveDayForecast", "/forecast", "forecast"), "fiveDayForecast"))
 	fmt.Println("Serviço A disponível em :8080")
 	http.ListenAndServe(":8080", mux)
 }
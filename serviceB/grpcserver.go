@@ -0,0 +1,149 @@
+package main
+
+import (
+	"context"
+	"net"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/walterlicinio/climacep-otel/weatherpb"
+)
+
+// weatherServer backs the gRPC surface with the same resolveCoordinates,
+// fetchForecast, and validation logic the HTTP handlers use, so behavior
+// stays identical across transports.
+type weatherServer struct {
+	weatherpb.UnimplementedWeatherServer
+}
+
+func (weatherServer) ValidateCep(ctx context.Context, req *weatherpb.ValidateCepRequest) (*weatherpb.ValidateCepReply, error) {
+	return &weatherpb.ValidateCepReply{Valid: validateCep(req.GetCep())}, nil
+}
+
+func (weatherServer) Location(ctx context.Context, req *weatherpb.LocationRequest) (*weatherpb.LocationReply, error) {
+	locationType := grpcLocationType(req.GetLocationType())
+
+	if !validateLocation(locationType, req.GetValue()) {
+		return nil, status.Error(codes.InvalidArgument, "invalid location")
+	}
+
+	lat, lon, city, err := resolveCoordinates(ctx, locationType, req.GetValue())
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "can not find location")
+	}
+
+	return &weatherpb.LocationReply{City: city, Latitude: lat, Longitude: lon}, nil
+}
+
+func (weatherServer) Forecast(ctx context.Context, req *weatherpb.RequestCurrent) (*weatherpb.SendCurrent, error) {
+	locationType := grpcLocationType(req.GetLocationType())
+	units := grpcUnits(req.GetUnits())
+
+	if !validateLocation(locationType, req.GetValue()) {
+		return nil, status.Error(codes.InvalidArgument, "invalid location")
+	}
+
+	lat, lon, city, err := resolveCoordinates(ctx, locationType, req.GetValue())
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "can not find location")
+	}
+
+	today, err := fetchForecast(lat, lon, forecastModeCurrent, units, 1)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "could not get temperature")
+	}
+
+	reading := today[0]
+	return &weatherpb.SendCurrent{
+		City:          city,
+		Temp:          reading.TempMax,
+		Unit:          unitLabel(units),
+		Humidity:      reading.Humidity,
+		PressureHpa:   reading.PressureHpa,
+		WindSpeed:     reading.WindSpeed,
+		WindDeg:       reading.WindDeg,
+		CloudCoverPct: reading.CloudCoverPct,
+		Condition:     reading.Condition,
+	}, nil
+}
+
+func (weatherServer) FiveDay(ctx context.Context, req *weatherpb.RequestCurrent) (*weatherpb.SendForecast, error) {
+	locationType := grpcLocationType(req.GetLocationType())
+	units := grpcUnits(req.GetUnits())
+
+	if !validateLocation(locationType, req.GetValue()) {
+		return nil, status.Error(codes.InvalidArgument, "invalid location")
+	}
+
+	lat, lon, city, err := resolveCoordinates(ctx, locationType, req.GetValue())
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "can not find location")
+	}
+
+	days, err := fetchForecast(lat, lon, forecastModeDaily, units, 5)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "could not get forecast")
+	}
+
+	pbDays := make([]*weatherpb.ForecastDay, 0, len(days))
+	for _, d := range days {
+		pbDays = append(pbDays, &weatherpb.ForecastDay{
+			Date:          d.Date,
+			TempMin:       d.TempMin,
+			TempMax:       d.TempMax,
+			Precipitation: d.Precipitation,
+			Condition:     d.Condition,
+			Humidity:      d.Humidity,
+			PressureHpa:   d.PressureHpa,
+			WindSpeed:     d.WindSpeed,
+			WindDeg:       d.WindDeg,
+			CloudCoverPct: d.CloudCoverPct,
+		})
+	}
+
+	return &weatherpb.SendForecast{City: city, Unit: unitLabel(units), Days: pbDays}, nil
+}
+
+func grpcLocationType(t weatherpb.LocationType) string {
+	switch t {
+	case weatherpb.LocationType_LOCATION_TYPE_CEP:
+		return locationTypeCEP
+	case weatherpb.LocationType_LOCATION_TYPE_CITY:
+		return locationTypeCity
+	case weatherpb.LocationType_LOCATION_TYPE_COORDS:
+		return locationTypeCoords
+	default:
+		return ""
+	}
+}
+
+func grpcUnits(u weatherpb.Units) string {
+	switch u {
+	case weatherpb.Units_UNITS_METRIC:
+		return unitsMetric
+	case weatherpb.Units_UNITS_IMPERIAL:
+		return unitsImperial
+	case weatherpb.Units_UNITS_STANDARD:
+		return unitsStandard
+	default:
+		return ""
+	}
+}
+
+// serveGRPC starts the gRPC listener on addr and blocks until it stops
+// accepting connections. otelgrpc's server handler produces the same
+// Zipkin trace tree as the HTTP path.
+func serveGRPC(addr string) error {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+
+	server := grpc.NewServer(grpc.StatsHandler(otelgrpc.NewServerHandler()))
+	weatherpb.RegisterWeatherServer(server, weatherServer{})
+
+	return server.Serve(lis)
+}
@@ -0,0 +1,349 @@
+// Package geocode turns a CEP, city name, or ViaCEP-resolved city name into
+// coordinates through a chain of providers that fail over into one another,
+// with an LRU cache in front so repeated lookups skip the network.
+package geocode
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2/expirable"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// LocationType mirrors the dispatch values temperatureHandler already
+// accepts in its request body.
+type LocationType string
+
+const (
+	CEP  LocationType = "cep"
+	City LocationType = "city"
+)
+
+// Typed errors so FallbackGeocoder can tell a "try the next provider"
+// failure from one worth giving up on.
+var (
+	ErrNotFound    = errors.New("geocode: location not found")
+	ErrRateLimited = errors.New("geocode: rate limited by provider")
+	ErrUpstream    = errors.New("geocode: upstream error")
+)
+
+// Request is what a Provider needs to geocode a location. CityName carries
+// the ViaCEP-resolved city name for CEP lookups, since name-based providers
+// can't geocode a raw CEP digit string directly.
+type Request struct {
+	LocationType LocationType
+	Value        string
+	CityName     string
+}
+
+// Provider geocodes a single Request. Implementations return ErrNotFound,
+// ErrRateLimited, or ErrUpstream so FallbackGeocoder can decide whether to
+// try the next provider in the chain.
+type Provider interface {
+	Name() string
+	Lookup(ctx context.Context, req Request) (lat, lon float64, err error)
+}
+
+// Geocoder is the interface the rest of Service B depends on.
+type Geocoder interface {
+	Lookup(ctx context.Context, req Request) (lat, lon float64, err error)
+}
+
+// FallbackGeocoder tries each provider in order, recording the provider name
+// and latency on the request's span, and moves on to the next provider
+// whenever one reports ErrNotFound, ErrRateLimited, or ErrUpstream.
+type FallbackGeocoder struct {
+	providers []Provider
+}
+
+func NewFallbackGeocoder(providers ...Provider) *FallbackGeocoder {
+	return &FallbackGeocoder{providers: providers}
+}
+
+func (g *FallbackGeocoder) Lookup(ctx context.Context, req Request) (lat, lon float64, err error) {
+	span := trace.SpanFromContext(ctx)
+
+	for _, p := range g.providers {
+		start := time.Now()
+		lat, lon, err = p.Lookup(ctx, req)
+		latency := time.Since(start)
+
+		span.SetAttributes(
+			attribute.String("geocode.provider", p.Name()),
+			attribute.Int64("geocode.latency_ms", latency.Milliseconds()),
+		)
+
+		if err == nil {
+			return lat, lon, nil
+		}
+		if errors.Is(err, ErrNotFound) || errors.Is(err, ErrRateLimited) || errors.Is(err, ErrUpstream) {
+			continue
+		}
+		return 0, 0, err
+	}
+
+	return 0, 0, ErrNotFound
+}
+
+// CachingGeocoder wraps a Geocoder with a bounded, TTL-expiring LRU cache
+// keyed by normalized location string, so repeated CEPs/cities skip the
+// network entirely.
+type CachingGeocoder struct {
+	inner Geocoder
+	cache *lru.LRU[string, coords]
+}
+
+type coords struct {
+	lat, lon float64
+}
+
+func NewCachingGeocoder(inner Geocoder) *CachingGeocoder {
+	return &CachingGeocoder{
+		inner: inner,
+		cache: lru.NewLRU[string, coords](10000, nil, 24*time.Hour),
+	}
+}
+
+func (g *CachingGeocoder) Lookup(ctx context.Context, req Request) (lat, lon float64, err error) {
+	key := normalize(req)
+	if c, ok := g.cache.Get(key); ok {
+		return c.lat, c.lon, nil
+	}
+
+	lat, lon, err = g.inner.Lookup(ctx, req)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	g.cache.Add(key, coords{lat: lat, lon: lon})
+	return lat, lon, nil
+}
+
+func normalize(req Request) string {
+	return string(req.LocationType) + "|" + strings.ToLower(strings.TrimSpace(req.Value))
+}
+
+// NominatimProvider geocodes free text (a city name, or the ViaCEP-resolved
+// city name for a CEP request) against OpenStreetMap's Nominatim service.
+type NominatimProvider struct{}
+
+func (NominatimProvider) Name() string { return "nominatim" }
+
+func (NominatimProvider) Lookup(ctx context.Context, req Request) (float64, float64, error) {
+	query := req.CityName
+	if query == "" {
+		query = req.Value
+	}
+	if query == "" {
+		return 0, 0, ErrNotFound
+	}
+
+	u := fmt.Sprintf("https://nominatim.openstreetmap.org/search?format=json&q=%s", url.QueryEscape(query))
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%w: %v", ErrUpstream, err)
+	}
+
+	resp, err := http.DefaultClient.Do(httpReq)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%w: %v", ErrUpstream, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return 0, 0, ErrRateLimited
+	}
+	if resp.StatusCode != http.StatusOK {
+		return 0, 0, fmt.Errorf("%w: non-200 response from nominatim: %s", ErrUpstream, resp.Status)
+	}
+
+	var data []struct {
+		Lat float64 `json:"lat,string"`
+		Lon float64 `json:"lon,string"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
+		return 0, 0, fmt.Errorf("%w: %v", ErrUpstream, err)
+	}
+	if len(data) == 0 {
+		return 0, 0, ErrNotFound
+	}
+
+	return data[0].Lat, data[0].Lon, nil
+}
+
+// AwesomeAPIProvider resolves a CEP straight to coordinates, skipping the
+// ViaCEP-then-geocode hop entirely.
+type AwesomeAPIProvider struct{}
+
+func (AwesomeAPIProvider) Name() string { return "awesomeapi" }
+
+func (AwesomeAPIProvider) Lookup(ctx context.Context, req Request) (float64, float64, error) {
+	if req.LocationType != CEP {
+		return 0, 0, ErrNotFound
+	}
+
+	u := fmt.Sprintf("https://cep.awesomeapi.com.br/json/%s", req.Value)
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%w: %v", ErrUpstream, err)
+	}
+
+	resp, err := http.DefaultClient.Do(httpReq)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%w: %v", ErrUpstream, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return 0, 0, ErrRateLimited
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return 0, 0, ErrNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return 0, 0, fmt.Errorf("%w: non-200 response from awesomeapi: %s, body: %s", ErrUpstream, resp.Status, body)
+	}
+
+	var data struct {
+		Lat string `json:"lat"`
+		Lon string `json:"lng"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
+		return 0, 0, fmt.Errorf("%w: %v", ErrUpstream, err)
+	}
+	if data.Lat == "" || data.Lon == "" {
+		return 0, 0, ErrNotFound
+	}
+
+	var lat, lon float64
+	if _, err := fmt.Sscanf(data.Lat, "%f", &lat); err != nil {
+		return 0, 0, fmt.Errorf("%w: %v", ErrUpstream, err)
+	}
+	if _, err := fmt.Sscanf(data.Lon, "%f", &lon); err != nil {
+		return 0, 0, fmt.Errorf("%w: %v", ErrUpstream, err)
+	}
+
+	return lat, lon, nil
+}
+
+// OpenWeatherProvider geocodes through OpenWeather's direct (city) and zip
+// (CEP) geocoding endpoints, mirroring the fetchCityCoords/fetchZipCoords
+// split.
+type OpenWeatherProvider struct {
+	APIKey string
+}
+
+func (OpenWeatherProvider) Name() string { return "openweather" }
+
+func (p OpenWeatherProvider) Lookup(ctx context.Context, req Request) (float64, float64, error) {
+	if p.APIKey == "" {
+		return 0, 0, ErrUpstream
+	}
+
+	switch req.LocationType {
+	case City:
+		return p.fetchCityCoords(ctx, req.Value)
+	case CEP:
+		return p.fetchZipCoords(ctx, req.Value)
+	default:
+		return 0, 0, ErrNotFound
+	}
+}
+
+func (p OpenWeatherProvider) fetchCityCoords(ctx context.Context, city string) (float64, float64, error) {
+	u := fmt.Sprintf("https://api.openweathermap.org/geo/1.0/direct?q=%s&limit=1&appid=%s", url.QueryEscape(city), p.APIKey)
+
+	var data []struct {
+		Lat float64 `json:"lat"`
+		Lon float64 `json:"lon"`
+	}
+	if err := p.getJSON(ctx, u, &data); err != nil {
+		return 0, 0, err
+	}
+	if len(data) == 0 {
+		return 0, 0, ErrNotFound
+	}
+
+	return data[0].Lat, data[0].Lon, nil
+}
+
+func (p OpenWeatherProvider) fetchZipCoords(ctx context.Context, zip string) (float64, float64, error) {
+	u := fmt.Sprintf("https://api.openweathermap.org/geo/1.0/zip?zip=%s,BR&appid=%s", zip, p.APIKey)
+
+	var data struct {
+		Lat float64 `json:"lat"`
+		Lon float64 `json:"lon"`
+	}
+	if err := p.getJSON(ctx, u, &data); err != nil {
+		return 0, 0, err
+	}
+
+	return data.Lat, data.Lon, nil
+}
+
+func (p OpenWeatherProvider) getJSON(ctx context.Context, u string, out interface{}) error {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrUpstream, err)
+	}
+
+	resp, err := http.DefaultClient.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrUpstream, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return ErrRateLimited
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return ErrNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w: non-200 response from openweather: %s", ErrUpstream, resp.Status)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("%w: %v", ErrUpstream, err)
+	}
+
+	return nil
+}
+
+// BuildChain resolves a comma-separated GEOCODER_CHAIN spec (e.g.
+// "viacep,nominatim,openweather") into an ordered provider list. "viacep" is
+// an alias for nominatim-by-resolved-city-name, since ViaCEP itself only
+// resolves a CEP to a city name, not coordinates.
+func BuildChain(spec, openWeatherAPIKey string) ([]Provider, error) {
+	if spec == "" {
+		spec = "viacep,awesomeapi"
+	}
+
+	var providers []Provider
+	for _, name := range strings.Split(spec, ",") {
+		switch strings.TrimSpace(name) {
+		case "viacep", "nominatim":
+			providers = append(providers, NominatimProvider{})
+		case "awesomeapi", "brasilapi":
+			providers = append(providers, AwesomeAPIProvider{})
+		case "openweather":
+			providers = append(providers, OpenWeatherProvider{APIKey: openWeatherAPIKey})
+		default:
+			return nil, fmt.Errorf("geocode: unknown provider %q in GEOCODER_CHAIN", name)
+		}
+	}
+
+	return providers, nil
+}
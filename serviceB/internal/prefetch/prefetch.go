@@ -0,0 +1,167 @@
+// Package prefetch keeps the upstream chain warm for CEPs that get hit right
+// before the well-known traffic spikes at the top and bottom of the hour,
+// modeled on the wttr.in peak-request cache.
+package prefetch
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/robfig/cron/v3"
+)
+
+// Request is the minimal shape of a temperature lookup, enough to recompute
+// it later without touching the original HTTP request.
+type Request struct {
+	Digest       string
+	LocationType string
+	Value        string
+	Units        string
+}
+
+// RefreshFunc re-runs a Request against the real upstream chain and returns
+// the JSON body that would be served to a caller.
+type RefreshFunc func(ctx context.Context, req Request) ([]byte, error)
+
+type cacheEntry struct {
+	body     []byte
+	cachedAt time.Time
+	lastHit  time.Time
+}
+
+// hotEntry pairs a hot Request with the last time it was observed, so
+// evictStale can drop it from the bucket once it's been idle past maxIdle.
+type hotEntry struct {
+	req      Request
+	lastSeen time.Time
+}
+
+// Scheduler tracks which requests are "hot" near the hour boundary, refreshes
+// them a few minutes ahead of the spike, and serves the result out of an
+// in-memory TTL cache so temperatureHandler can skip the upstream chain
+// entirely for those CEPs.
+type Scheduler struct {
+	tracer  trace.Tracer
+	refresh RefreshFunc
+	ttl     time.Duration
+	maxIdle time.Duration
+
+	firstHalf  sync.Map // digest -> hotEntry, hit between minute 25-30
+	secondHalf sync.Map // digest -> hotEntry, hit between minute 55-60
+
+	cache sync.Map // digest -> cacheEntry
+}
+
+// NewScheduler builds a Scheduler that caches fresh results for ttl and
+// refreshes them via refresh. Entries that go a full hour without being
+// requested again are evicted.
+func NewScheduler(tracer trace.Tracer, refresh RefreshFunc, ttl time.Duration) *Scheduler {
+	return &Scheduler{
+		tracer:  tracer,
+		refresh: refresh,
+		ttl:     ttl,
+		maxIdle: time.Hour,
+	}
+}
+
+// Digest builds the cache key for a request, keyed by CEP (or other
+// location value), units, and location type.
+func Digest(locationType, value, units string) string {
+	return locationType + "|" + value + "|" + units
+}
+
+// Observe records the request as hot if it falls in one of the two
+// pre-spike windows (minute 25-30 or 55-60), so the next cron pass refreshes
+// it ahead of time.
+func (s *Scheduler) Observe(req Request) {
+	now := time.Now()
+	minute := now.Minute()
+	switch {
+	case minute >= 25 && minute < 30:
+		s.firstHalf.Store(req.Digest, hotEntry{req: req, lastSeen: now})
+	case minute >= 55 && minute < 60:
+		s.secondHalf.Store(req.Digest, hotEntry{req: req, lastSeen: now})
+	}
+}
+
+// Lookup returns a cached body if one exists and hasn't expired, marking the
+// entry as recently hit so it survives the idle eviction pass.
+func (s *Scheduler) Lookup(digest string) ([]byte, bool) {
+	v, ok := s.cache.Load(digest)
+	if !ok {
+		return nil, false
+	}
+
+	entry := v.(cacheEntry)
+	if time.Since(entry.cachedAt) > s.ttl {
+		return nil, false
+	}
+
+	entry.lastHit = time.Now()
+	s.cache.Store(digest, entry)
+	return entry.body, true
+}
+
+// Start registers the two cron jobs that fire ahead of each hour's peak
+// windows (minute 24 and 54) and begins running them in the background.
+func (s *Scheduler) Start() (*cron.Cron, error) {
+	c := cron.New()
+
+	if _, err := c.AddFunc("24 * * * *", func() { s.refreshBucket(&s.firstHalf) }); err != nil {
+		return nil, err
+	}
+	if _, err := c.AddFunc("54 * * * *", func() { s.refreshBucket(&s.secondHalf) }); err != nil {
+		return nil, err
+	}
+
+	c.Start()
+	return c, nil
+}
+
+func (s *Scheduler) refreshBucket(bucket *sync.Map) {
+	bucket.Range(func(key, value interface{}) bool {
+		req := value.(hotEntry).req
+
+		ctx, span := s.tracer.Start(context.Background(), "prefetch")
+		span.SetAttributes(attribute.Bool("prefetch", true))
+
+		body, err := s.refresh(ctx, req)
+		span.End()
+		if err != nil {
+			return true
+		}
+
+		now := time.Now()
+		s.cache.Store(req.Digest, cacheEntry{body: body, cachedAt: now, lastHit: now})
+		return true
+	})
+
+	s.evictStale()
+}
+
+// evictStale drops entries that haven't been hit in the last maxIdle from
+// both the result cache and the hot-set buckets, so a one-off request
+// doesn't keep getting refreshed against the upstream chain forever.
+func (s *Scheduler) evictStale() {
+	cutoff := time.Now().Add(-s.maxIdle)
+
+	s.cache.Range(func(key, value interface{}) bool {
+		if value.(cacheEntry).lastHit.Before(cutoff) {
+			s.cache.Delete(key)
+		}
+		return true
+	})
+
+	for _, bucket := range []*sync.Map{&s.firstHalf, &s.secondHalf} {
+		bucket.Range(func(key, value interface{}) bool {
+			if value.(hotEntry).lastSeen.Before(cutoff) {
+				bucket.Delete(key)
+			}
+			return true
+		})
+	}
+}
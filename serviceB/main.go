@@ -1,12 +1,16 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"log"
 	"net/http"
-	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"go.opentelemetry.io/otel"
@@ -16,9 +20,14 @@ import (
 	"go.opentelemetry.io/otel/sdk/resource"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"go.opentelemetry.io/otel/trace"
+
+	"github.com/walterlicinio/climacep-otel/serviceB/internal/geocode"
+	"github.com/walterlicinio/climacep-otel/serviceB/internal/prefetch"
 )
 
 var tracer trace.Tracer
+var prefetchScheduler *prefetch.Scheduler
+var geocoder geocode.Geocoder
 
 func initTracer() {
 	exporter, err := stdouttrace.New(stdouttrace.WithPrettyPrint())
@@ -42,20 +51,102 @@ func initTracer() {
 	tracer = tp.Tracer("serviceb")
 }
 
+const (
+	locationTypeCEP    = "cep"
+	locationTypeCity   = "city"
+	locationTypeCoords = "coords"
+)
+
+const (
+	unitsMetric   = "metric"
+	unitsImperial = "imperial"
+	unitsStandard = "standard"
+)
+
+const (
+	forecastModeCurrent = "current"
+	forecastModeDaily   = "forecast"
+)
+
 type ViaCepResponse struct {
 	Error      bool   `json:"erro"`
 	Localidade string `json:"localidade"`
 }
 
-type NominatimResponse struct {
-	Lat float64 `json:"lat,string"`
-	Lon float64 `json:"lon,string"`
-}
-
 type OpenMeteoResponse struct {
 	CurrentWeather struct {
-		Temperature float64 `json:"temperature"`
+		Temperature   float64 `json:"temperature"`
+		Windspeed     float64 `json:"windspeed"`
+		Winddirection float64 `json:"winddirection"`
+		Weathercode   int     `json:"weathercode"`
+		Time          string  `json:"time"`
 	} `json:"current_weather"`
+	Hourly struct {
+		Time               []string  `json:"time"`
+		RelativeHumidity2m []float64 `json:"relativehumidity_2m"`
+		SurfacePressure    []float64 `json:"surface_pressure"`
+		Cloudcover         []float64 `json:"cloudcover"`
+	} `json:"hourly"`
+	Daily struct {
+		Time             []string  `json:"time"`
+		Temperature2mMax []float64 `json:"temperature_2m_max"`
+		Temperature2mMin []float64 `json:"temperature_2m_min"`
+		PrecipitationSum []float64 `json:"precipitation_sum"`
+		Weathercode      []int     `json:"weathercode"`
+	} `json:"daily"`
+}
+
+// ForecastDay is one entry of a weather prediction, whether it's the single
+// "today" reading from the current endpoint or one of the five days returned
+// by the forecast endpoint.
+type ForecastDay struct {
+	Date          string  `json:"date"`
+	TempMin       float64 `json:"tempMin"`
+	TempMax       float64 `json:"tempMax"`
+	Precipitation float64 `json:"precipitation"`
+	Condition     string  `json:"condition,omitempty"`
+	Humidity      float64 `json:"humidity,omitempty"`
+	PressureHpa   float64 `json:"pressure_hpa,omitempty"`
+	WindSpeed     float64 `json:"wind_speed,omitempty"`
+	WindDeg       float64 `json:"wind_deg,omitempty"`
+	CloudCoverPct float64 `json:"cloud_cover_pct,omitempty"`
+}
+
+// weathercodeCondition maps an Open-Meteo (WMO) weathercode to the
+// human-readable condition string the API returns.
+func weathercodeCondition(code int) string {
+	switch {
+	case code == 0:
+		return "Clear"
+	case code == 1 || code == 2 || code == 3:
+		return "Partly cloudy"
+	case code == 45 || code == 48:
+		return "Fog"
+	case code >= 51 && code <= 57:
+		return "Drizzle"
+	case code >= 61 && code <= 67, code >= 80 && code <= 82:
+		return "Rain"
+	case code >= 71 && code <= 77, code == 85 || code == 86:
+		return "Snow"
+	case code >= 95 && code <= 99:
+		return "Thunderstorm"
+	default:
+		return ""
+	}
+}
+
+// hourlyIndexForTime finds the row in an Open-Meteo hourly response whose
+// timestamp exactly matches target (the current_weather.time value),
+// returning -1 if there's no match. Because the whole timestamp is
+// compared, the first hour of a new day (midnight) selects its own row,
+// not the previous day's last one.
+func hourlyIndexForTime(times []string, target string) int {
+	for i, t := range times {
+		if t == target {
+			return i
+		}
+	}
+	return -1
 }
 
 func getCity(cep string) ViaCepResponse {
@@ -79,54 +170,97 @@ func getCity(cep string) ViaCepResponse {
 	return data
 }
 
-func getCoordinates(location string) (float64, float64, error) {
-	url := fmt.Sprintf("https://nominatim.openstreetmap.org/search?format=json&q=%s", url.QueryEscape(location))
-
-	resp, err := http.Get(url)
-	if err != nil {
-		return 0, 0, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return 0, 0, fmt.Errorf("non-200 response from geocoding service: %s, body: %s", resp.Status, body)
-	}
+// createUrl builds the Open-Meteo request for either the single
+// current-weather reading or the multi-day forecast, keeping URL assembly in
+// one place for both endpoints.
+func createUrl(latitude, longitude float64, mode string, days int) string {
+	base := fmt.Sprintf("https://api.open-meteo.com/v1/forecast?latitude=%f&longitude=%f", latitude, longitude)
 
-	var data []NominatimResponse
-	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
-		return 0, 0, err
-	}
-	if len(data) == 0 {
-		return 0, 0, fmt.Errorf("no results found for location")
+	if mode == forecastModeDaily {
+		return fmt.Sprintf("%s&daily=temperature_2m_max,temperature_2m_min,precipitation_sum,weathercode&forecast_days=%d", base, days)
 	}
 
-	return data[0].Lat, data[0].Lon, nil
+	return base + "&current_weather=true&hourly=relativehumidity_2m,surface_pressure,cloudcover"
 }
 
-func getTemperature(latitude, longitude float64) (float64, error) {
-	url := fmt.Sprintf("https://api.open-meteo.com/v1/forecast?latitude=%f&longitude=%f&current_weather=true", latitude, longitude)
+// fetchForecast fetches either today's reading or a multi-day forecast from
+// Open-Meteo and converts every temperature to the requested units, so URL
+// assembly, unit conversion, and error mapping live in a single place shared
+// by the current-weather and five-day endpoints.
+func fetchForecast(latitude, longitude float64, mode, units string, days int) ([]ForecastDay, error) {
+	url := createUrl(latitude, longitude, mode, days)
 
 	log.Printf("URL Request: %s", url)
 
 	resp, err := http.Get(url)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
 		log.Printf("Response Body: %s", body)
-		return 0, fmt.Errorf("non-200 response from Open Meteo: %s, body: %s", resp.Status, body)
+		return nil, fmt.Errorf("non-200 response from Open Meteo: %s, body: %s", resp.Status, body)
 	}
 
 	var data OpenMeteoResponse
 	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
-		return 0, err
+		return nil, err
 	}
 
-	return data.CurrentWeather.Temperature, nil
+	var result []ForecastDay
+	if mode == forecastModeDaily {
+		for i, date := range data.Daily.Time {
+			tempMin, _ := convertTemp(valueAt(data.Daily.Temperature2mMin, i), units)
+			tempMax, _ := convertTemp(valueAt(data.Daily.Temperature2mMax, i), units)
+			result = append(result, ForecastDay{
+				Date:          date,
+				TempMin:       tempMin,
+				TempMax:       tempMax,
+				Precipitation: valueAt(data.Daily.PrecipitationSum, i),
+				Condition:     weathercodeCondition(valueAtInt(data.Daily.Weathercode, i)),
+			})
+		}
+		return result, nil
+	}
+
+	temp, _ := convertTemp(data.CurrentWeather.Temperature, units)
+	date := data.CurrentWeather.Time
+	if idx := strings.Index(date, "T"); idx != -1 {
+		date = date[:idx]
+	}
+
+	day := ForecastDay{
+		Date:      date,
+		TempMin:   temp,
+		TempMax:   temp,
+		Condition: weathercodeCondition(data.CurrentWeather.Weathercode),
+		WindSpeed: data.CurrentWeather.Windspeed,
+		WindDeg:   data.CurrentWeather.Winddirection,
+	}
+
+	if idx := hourlyIndexForTime(data.Hourly.Time, data.CurrentWeather.Time); idx != -1 {
+		day.Humidity = valueAt(data.Hourly.RelativeHumidity2m, idx)
+		day.PressureHpa = valueAt(data.Hourly.SurfacePressure, idx)
+		day.CloudCoverPct = valueAt(data.Hourly.Cloudcover, idx)
+	}
+
+	return []ForecastDay{day}, nil
+}
+
+func valueAt(values []float64, i int) float64 {
+	if i < 0 || i >= len(values) {
+		return 0
+	}
+	return values[i]
+}
+
+func valueAtInt(values []int, i int) int {
+	if i < 0 || i >= len(values) {
+		return 0
+	}
+	return values[i]
 }
 
 func validateCep(cep string) bool {
@@ -143,9 +277,96 @@ func validateCep(cep string) bool {
 
 }
 
+func validateCoords(value string) bool {
+	_, _, err := parseCoords(value)
+	return err == nil
+}
+
+func parseCoords(value string) (float64, float64, error) {
+	parts := strings.SplitN(value, ",", 2)
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("coords must be in \"lat,lon\" format")
+	}
+
+	lat, err := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid latitude: %w", err)
+	}
+
+	lon, err := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid longitude: %w", err)
+	}
+
+	return lat, lon, nil
+}
+
+func validateLocation(locationType, value string) bool {
+	switch locationType {
+	case locationTypeCEP:
+		return validateCep(value)
+	case locationTypeCity:
+		return value != ""
+	case locationTypeCoords:
+		return validateCoords(value)
+	default:
+		return false
+	}
+}
+
+// resolveCoordinates dispatches on locationType the way the upstream chain
+// requires: cep goes through ViaCEP to get a display name before geocoding,
+// city geocodes the name directly, and coords needs no geocoding at all.
+func resolveCoordinates(ctx context.Context, locationType, value string) (lat, lon float64, city string, err error) {
+	switch locationType {
+	case locationTypeCEP:
+		cepCity := getCity(value)
+		if cepCity.Error {
+			return 0, 0, "", fmt.Errorf("can not find zipcode")
+		}
+		lat, lon, err = geocoder.Lookup(ctx, geocode.Request{
+			LocationType: geocode.CEP,
+			Value:        value,
+			CityName:     cepCity.Localidade,
+		})
+		return lat, lon, cepCity.Localidade, err
+	case locationTypeCity:
+		lat, lon, err = geocoder.Lookup(ctx, geocode.Request{
+			LocationType: geocode.City,
+			Value:        value,
+		})
+		return lat, lon, value, err
+	case locationTypeCoords:
+		lat, lon, err = parseCoords(value)
+		return lat, lon, "", err
+	default:
+		return 0, 0, "", fmt.Errorf("unsupported location type: %s", locationType)
+	}
+}
+
+// convertTemp applies the unit the caller asked for, returning the short
+// label ("C", "F", "K") that goes alongside it in the response.
+func convertTemp(tempC float64, units string) (float64, string) {
+	switch units {
+	case unitsImperial:
+		return tempC*1.8 + 32, "F"
+	case unitsStandard:
+		return tempC + 273, "K"
+	default:
+		return tempC, "C"
+	}
+}
+
+func unitLabel(units string) string {
+	_, label := convertTemp(0, units)
+	return label
+}
+
 func temperatureHandler(w http.ResponseWriter, r *http.Request) {
 	var request struct {
-		Cep string `json:"cep"`
+		LocationType string `json:"location_type"`
+		Value        string `json:"value"`
+		Units        string `json:"units"`
 	}
 
 	body, err := io.ReadAll(r.Body)
@@ -159,34 +380,130 @@ func temperatureHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if !validateCep(request.Cep) {
-		http.Error(w, "invalid zipcode", http.StatusUnprocessableEntity)
+	span := trace.SpanFromContext(r.Context())
+	span.SetAttributes(
+		attribute.String("location.type", request.LocationType),
+		attribute.String("units", request.Units),
+	)
+
+	if !validateLocation(request.LocationType, request.Value) {
+		http.Error(w, "invalid location", http.StatusUnprocessableEntity)
+		return
+	}
+
+	digest := prefetch.Digest(request.LocationType, request.Value, request.Units)
+	respBody, cached := prefetchScheduler.Lookup(digest)
+	if !cached {
+		var status int
+		var err error
+		respBody, status, err = buildCurrentWeather(r.Context(), request.LocationType, request.Value, request.Units)
+		if err != nil {
+			http.Error(w, err.Error(), status)
+			return
+		}
+	}
+
+	prefetchScheduler.Observe(prefetch.Request{
+		Digest:       digest,
+		LocationType: request.LocationType,
+		Value:        request.Value,
+		Units:        request.Units,
+	})
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(respBody)
+}
+
+// buildCurrentWeather runs the full ViaCEP/Nominatim/Open-Meteo chain for a
+// single location and returns the JSON body temperatureHandler would send.
+// It's shared with the prefetch scheduler so a cron-triggered refresh and a
+// live request produce byte-identical responses.
+func buildCurrentWeather(ctx context.Context, locationType, value, units string) ([]byte, int, error) {
+	lat, lon, city, err := resolveCoordinates(ctx, locationType, value)
+	if err != nil {
+		return nil, http.StatusNotFound, fmt.Errorf("can not find location")
+	}
+
+	today, err := fetchForecast(lat, lon, forecastModeCurrent, units, 1)
+	if err != nil {
+		return nil, http.StatusInternalServerError, fmt.Errorf("could not get temperature")
+	}
+	reading := today[0]
+	tempC := reading.TempMax
+
+	response := map[string]interface{}{}
+	if city != "" {
+		response["city"] = city
+	}
+
+	if units != "" {
+		response["temp"] = tempC
+		response["unit"] = unitLabel(units)
+	} else {
+		response["temp_C"] = tempC
+		response["temp_F"] = tempC*1.8 + 32
+		response["temp_K"] = tempC + 273
+	}
+
+	response["humidity"] = reading.Humidity
+	response["pressure_hpa"] = reading.PressureHpa
+	response["wind_speed"] = reading.WindSpeed
+	response["wind_deg"] = reading.WindDeg
+	response["cloud_cover_pct"] = reading.CloudCoverPct
+	response["condition"] = reading.Condition
+
+	body, err := json.Marshal(response)
+	return body, http.StatusOK, err
+}
+
+func forecastHandler(w http.ResponseWriter, r *http.Request) {
+	var request struct {
+		LocationType string `json:"location_type"`
+		Value        string `json:"value"`
+		Units        string `json:"units"`
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, "could not read request body", http.StatusInternalServerError)
+		return
+	}
+
+	if err := json.Unmarshal(body, &request); err != nil {
+		http.Error(w, "invalid request format", http.StatusBadRequest)
 		return
 	}
 
-	city := getCity(request.Cep)
-	if city.Error {
-		http.Error(w, "can not find zipcode", http.StatusNotFound)
+	span := trace.SpanFromContext(r.Context())
+	span.SetAttributes(
+		attribute.String("location.type", request.LocationType),
+		attribute.String("units", request.Units),
+	)
+
+	if !validateLocation(request.LocationType, request.Value) {
+		http.Error(w, "invalid location", http.StatusUnprocessableEntity)
 		return
 	}
 
-	lat, lon, err := getCoordinates(city.Localidade)
+	lat, lon, city, err := resolveCoordinates(r.Context(), request.LocationType, request.Value)
 	if err != nil {
-		http.Error(w, "can not find zipcode", http.StatusNotFound)
+		http.Error(w, "can not find location", http.StatusNotFound)
 		return
 	}
 
-	tempC, err := getTemperature(lat, lon)
+	days, err := fetchForecast(lat, lon, forecastModeDaily, request.Units, 5)
 	if err != nil {
-		http.Error(w, "could not get temperature", http.StatusInternalServerError)
+		http.Error(w, "could not get forecast", http.StatusInternalServerError)
 		return
 	}
 
 	response := map[string]interface{}{
-		"city":   city.Localidade,
-		"temp_C": tempC,
-		"temp_F": tempC*1.8 + 32,
-		"temp_K": tempC + 273,
+		"forecast": days,
+		"unit":     unitLabel(request.Units),
+	}
+	if city != "" {
+		response["city"] = city
 	}
 
 	respBody, _ := json.Marshal(response)
@@ -198,9 +515,30 @@ func temperatureHandler(w http.ResponseWriter, r *http.Request) {
 func main() {
 	initTracer()
 
+	providers, err := geocode.BuildChain(os.Getenv("GEOCODER_CHAIN"), os.Getenv("OPENWEATHER_API_KEY"))
+	if err != nil {
+		log.Fatalf("failed to build geocoder chain: %v", err)
+	}
+	geocoder = geocode.NewCachingGeocoder(geocode.NewFallbackGeocoder(providers...))
+
+	prefetchScheduler = prefetch.NewScheduler(tracer, func(ctx context.Context, req prefetch.Request) ([]byte, error) {
+		body, _, err := buildCurrentWeather(ctx, req.LocationType, req.Value, req.Units)
+		return body, err
+	}, 5*time.Minute)
+	if _, err := prefetchScheduler.Start(); err != nil {
+		log.Fatalf("failed to start prefetch scheduler: %v", err)
+	}
+
+	go func() {
+		if err := serveGRPC(":9091"); err != nil {
+			log.Fatalf("gRPC server failed: %v", err)
+		}
+	}()
+
 	mux := http.NewServeMux()
-	mux.Handle("/cep", otelhttp.NewHandler(http.HandlerFunc(temperatureHandler), "temperatureHandler"))
+	mux.Handle("/cep", otelhttp.NewHandler(http.HandlerFunc(temperatureHandler), "currentForecast"))
+	mux.Handle("/forecast", otelhttp.NewHandler(http.HandlerFunc(forecastHandler), "fiveDayForecast"))
 
-	fmt.Println("Serviço B disponível em :8081")
+	fmt.Println("Serviço B disponível em :8081 (HTTP) e :9091 (gRPC)")
 	http.ListenAndServe(":8081", mux)
 }
@@ -0,0 +1,39 @@
+package main
+
+import "testing"
+
+func TestWeathercodeCondition(t *testing.T) {
+	cases := []struct {
+		code int
+		want string
+	}{
+		{0, "Clear"},
+		{2, "Partly cloudy"},
+		{45, "Fog"},
+		{53, "Drizzle"},
+		{63, "Rain"},
+		{81, "Rain"},
+		{73, "Snow"},
+		{85, "Snow"},
+		{95, "Thunderstorm"},
+		{999, ""},
+	}
+
+	for _, c := range cases {
+		if got := weathercodeCondition(c.code); got != c.want {
+			t.Errorf("weathercodeCondition(%d) = %q, want %q", c.code, got, c.want)
+		}
+	}
+}
+
+func TestHourlyIndexForTime(t *testing.T) {
+	times := []string{"2024-01-01T23:00", "2024-01-02T00:00", "2024-01-02T01:00"}
+
+	if idx := hourlyIndexForTime(times, "2024-01-02T00:00"); idx != 1 {
+		t.Errorf("hourlyIndexForTime at midnight = %d, want 1", idx)
+	}
+
+	if idx := hourlyIndexForTime(times, "2024-01-03T00:00"); idx != -1 {
+		t.Errorf("hourlyIndexForTime for missing time = %d, want -1", idx)
+	}
+}